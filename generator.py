@@ -12,11 +12,13 @@ import usefullFunk
 import keyBoard
 import genetik
 import argparse
+import random
 import sys
+import time
 from my_print import my_print
 
 def init_kb(args):
-    keyboard = keyBoard.KeyBoard("qwerty", seed=args.seed)
+    keyboard = keyBoard.KeyBoard("qwerty")
     keyboard.toGraph()
     if (args.show_dot):
         print(keyboard.graph.toDot())
@@ -30,16 +32,71 @@ def init_kb(args):
 
 def main(args):
     keyboard = init_kb(args)
-    play(args.generation_number, args.population_size, args.klf)
+    multiStart(args.multi_start, args.generation_number,
+               args.population_size, args.klf, args.seed, args.max_time)
 
-def play(genNumber, popSize, fileName):
-    pop = [keyBoard.KeyBoard("random", seed=42) for x in range(popSize)]
+def initPop(popSize, seed=None):
+    """
+    Seed the RNG with <seed> and give back <popSize> random keyboards
+    The same <seed> always gives back the same population
+    """
+    random.seed(seed)
+    return [keyBoard.KeyBoard("random") for x in range(popSize)]
+
+def play(genNumber, popSize, fileName, seed=None, maxTime=None):
+    """
+    Evolve the population for <genNumber> generations
+    or until <maxTime> seconds have passed if it is set
+    At least one generation is always run
+    """
+    start = time.monotonic()
+    pop = initPop(popSize, seed)
     genetik.sortPop(pop, 0, popSize - 1)
     for gen in range(genNumber):
         for kb in pop:
             kb.valuate()
         genetik.evolve(pop, fileName)
         print(pop[0], gen)# Print the best keyboard for each generation
+        if maxTime is not None and time.monotonic() - start >= maxTime:
+            break
+    return min(pop, key=lambda kb: kb.val)
+
+def multiStart(runs, genNumber, popSize, fileName, seed=None,
+               maxTime=None):
+    """
+    Call play() <runs> times, each from a fresh population,
+    and give back the best keyboard of all the runs
+    <maxTime> is shared by all the runs
+    """
+    start = time.monotonic()
+    bests = []
+    for run in range(runs):
+        runSeed = None if seed is None else seed + run
+        runTime = None
+        if maxTime is not None:
+            runTime = max(0, maxTime - (time.monotonic() - start))
+        bests.append(play(genNumber, popSize, fileName, runSeed, runTime))
+    best = min(range(runs), key=lambda run: bests[run].val)
+    if runs > 1:
+        for run in range(runs):
+            print("run {}: {}".format(run, bests[run].val))
+        print("best run: {}".format(best))
+    print("elapsed time: {:.2f}s".format(time.monotonic() - start))
+    return bests[best]
+
+def nonNegativeFloat(value):
+    """argparse type for a float that is >= 0"""
+    res = float(value)
+    if res < 0:
+        raise argparse.ArgumentTypeError("{} is negative".format(value))
+    return res
+
+def positiveInt(value):
+    """argparse type for an int that is >= 1"""
+    res = int(value)
+    if res < 1:
+        raise argparse.ArgumentTypeError("{} is lower than 1".format(value))
+    return res
 
 def parse_args():
     parser = argparse.ArgumentParser(str(sys.argv[0]))
@@ -59,6 +116,14 @@ def parse_args():
     parser.add_argument("--population-size", "--ps", "-p",
                         metavar="nb", type=int, default=10,
                         help="represent the population size to evolve")
+    parser.add_argument("--max-time",
+                        metavar="sec", type=nonNegativeFloat, default=None,
+                        help="stop evolving after sec seconds, shared by all"
+                             " the runs (default=none)")
+    parser.add_argument("--multi-start",
+                        metavar="nb", type=positiveInt, default=1,
+                        help="number of independent runs to keep the best of"
+                             " (default=1)")
     parser.add_argument("--seed", "-s",
                         metavar="seed", type=int, default=None,
                         help="to set the seed")
@@ -5,16 +5,15 @@ Created on tue Jun 8 20:00:00 2017
 @author: tm
 This contain everything about keyboard
 To use:
-k = keyboard("random|qwerty|azerty")
+k = keyboard("random|qwerty|azerty|colemak|dvorak|workman")
 k.note()
 k.randomize()
 """
 
 # imports
+import copy
 import usefullFunk
 from my_print import my_print
-# random
-import random
 
 DEFAULTLAYOUT = [
     [
@@ -42,6 +41,78 @@ DEFAULTLAYOUT = [
     ]
 ]
 QWERTY = DEFAULTLAYOUT
+COLEMAK = [
+    [
+        [" # default mode"],
+        ["`", "1", "2", "3", "4", "5", "6", "7", "8", "9", "0", "-", "=", "{backspace}"],
+        ["{tab}", "q", "w", "f", "p", "g", "j", "l", "u", "y", ";", "[", "]", "\\"],
+        ["{caps}", "a", "r", "s", "t", "d", "h", "n", "e", "i", "o", "'", "{enter}"],
+        ["{shiftl}", "z", "x", "c", "v", "b", "k", "m", ",", ".", "/", "{shiftr}"],
+        ["{next}", "{space}", "{accept}" ],
+    ],[
+        [" # shifted mode"],
+        ["~", "!", "@", "#", "$", "%", "^", "&", "*", "(", ")", "_", "+", "{backspace}"],
+        ["{tab}", "Q", "W", "F", "P", "G", "J", "L", "U", "Y", ":", "{", "}", "|"],
+        ["{caps}", "A", "R", "S", "T", "D", "H", "N", "E", "I", "O", "\"", "{enter}"],
+        ["{shiftl}", "Z", "X", "C", "V", "B", "K", "M", "<", ">", "?", "{shiftr}"],
+        ["{next}", "{space}", "{accept}" ],
+    ],[
+        [" # capsed mode"],
+        ["`", "1", "2", "3", "4", "5", "6", "7", "8", "9", "0", "-", "=", "{backspace}"],
+        ["{tab}", "Q", "W", "F", "P", "G", "J", "L", "U", "Y", ";", "[", "]", "\\"],
+        ["{caps}", "A", "R", "S", "T", "D", "H", "N", "E", "I", "O", "'", "{enter}"],
+        ["{shiftl}", "Z", "X", "C", "V", "B", "K", "M", ",", ".", "/", "{shiftr}"],
+        ["{next}", "{space}", "{accept}" ],
+    ]
+]
+DVORAK = [
+    [
+        [" # default mode"],
+        ["`", "1", "2", "3", "4", "5", "6", "7", "8", "9", "0", "[", "]", "{backspace}"],
+        ["{tab}", "'", ",", ".", "p", "y", "f", "g", "c", "r", "l", "/", "=", "\\"],
+        ["{caps}", "a", "o", "e", "u", "i", "d", "h", "t", "n", "s", "-", "{enter}"],
+        ["{shiftl}", ";", "q", "j", "k", "x", "b", "m", "w", "v", "z", "{shiftr}"],
+        ["{next}", "{space}", "{accept}" ],
+    ],[
+        [" # shifted mode"],
+        ["~", "!", "@", "#", "$", "%", "^", "&", "*", "(", ")", "{", "}", "{backspace}"],
+        ["{tab}", "\"", "<", ">", "P", "Y", "F", "G", "C", "R", "L", "?", "+", "|"],
+        ["{caps}", "A", "O", "E", "U", "I", "D", "H", "T", "N", "S", "_", "{enter}"],
+        ["{shiftl}", ":", "Q", "J", "K", "X", "B", "M", "W", "V", "Z", "{shiftr}"],
+        ["{next}", "{space}", "{accept}" ],
+    ],[
+        [" # capsed mode"],
+        ["`", "1", "2", "3", "4", "5", "6", "7", "8", "9", "0", "[", "]", "{backspace}"],
+        ["{tab}", "'", ",", ".", "P", "Y", "F", "G", "C", "R", "L", "/", "=", "\\"],
+        ["{caps}", "A", "O", "E", "U", "I", "D", "H", "T", "N", "S", "-", "{enter}"],
+        ["{shiftl}", ";", "Q", "J", "K", "X", "B", "M", "W", "V", "Z", "{shiftr}"],
+        ["{next}", "{space}", "{accept}" ],
+    ]
+]
+WORKMAN = [
+    [
+        [" # default mode"],
+        ["`", "1", "2", "3", "4", "5", "6", "7", "8", "9", "0", "-", "=", "{backspace}"],
+        ["{tab}", "q", "d", "r", "w", "b", "j", "f", "u", "p", ";", "[", "]", "\\"],
+        ["{caps}", "a", "s", "h", "t", "g", "y", "n", "e", "o", "i", "'", "{enter}"],
+        ["{shiftl}", "z", "x", "m", "c", "v", "k", "l", ",", ".", "/", "{shiftr}"],
+        ["{next}", "{space}", "{accept}" ],
+    ],[
+        [" # shifted mode"],
+        ["~", "!", "@", "#", "$", "%", "^", "&", "*", "(", ")", "_", "+", "{backspace}"],
+        ["{tab}", "Q", "D", "R", "W", "B", "J", "F", "U", "P", ":", "{", "}", "|"],
+        ["{caps}", "A", "S", "H", "T", "G", "Y", "N", "E", "O", "I", "\"", "{enter}"],
+        ["{shiftl}", "Z", "X", "M", "C", "V", "K", "L", "<", ">", "?", "{shiftr}"],
+        ["{next}", "{space}", "{accept}" ],
+    ],[
+        [" # capsed mode"],
+        ["`", "1", "2", "3", "4", "5", "6", "7", "8", "9", "0", "-", "=", "{backspace}"],
+        ["{tab}", "Q", "D", "R", "W", "B", "J", "F", "U", "P", ";", "[", "]", "\\"],
+        ["{caps}", "A", "S", "H", "T", "G", "Y", "N", "E", "O", "I", "'", "{enter}"],
+        ["{shiftl}", "Z", "X", "M", "C", "V", "K", "L", ",", ".", "/", "{shiftr}"],
+        ["{next}", "{space}", "{accept}" ],
+    ]
+]
 """
 AZERTY = [
     [
@@ -99,19 +170,23 @@ class KeyBoard():
         ]
     ]
     """
-    def __init__(self, mode, keys=[], seed=None, debug=False):
+    def __init__(self, mode, keys=[], debug=False):
         self.mode = mode
         self.keyLayout = keys
-        self.seed = seed
         self.graph = None
         self.keys = []# This is a key list, and a id list
         self.val = 0
         self.debug = debug
-        random.seed(self.seed)
         if self.mode == "azerty":  
             self.keyLayout = AZERTY
         elif self.mode == "qwerty":
             self.keyLayout = QWERTY
+        elif self.mode == "colemak":
+            self.keyLayout = copy.deepcopy(COLEMAK)
+        elif self.mode == "dvorak":
+            self.keyLayout = copy.deepcopy(DVORAK)
+        elif self.mode == "workman":
+            self.keyLayout = copy.deepcopy(WORKMAN)
         elif self.mode == "random":
             # print("Taking a random keyboard")
             self.keyLayout = DEFAULTLAYOUT
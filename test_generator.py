@@ -0,0 +1,93 @@
+# -*- coding: utf-8 -*-
+"""
+@author: tm
+Tests for the generator
+To run: python3 -m unittest
+"""
+
+import argparse
+import contextlib
+import io
+import unittest
+from unittest import mock
+import generator
+
+class TestInitPop(unittest.TestCase):
+    def test_same_seed_same_population(self):
+        first = [str(kb) for kb in generator.initPop(3, seed=5)]
+        second = [str(kb) for kb in generator.initPop(3, seed=5)]
+        self.assertEqual(first, second)
+
+    def test_population_is_diverse(self):
+        pop = [str(kb) for kb in generator.initPop(3, seed=5)]
+        self.assertEqual(len(set(pop)), 3)
+
+@mock.patch("generator.genetik.sortPop")
+@mock.patch("generator.genetik.evolve")
+class TestPlay(unittest.TestCase):
+    def play(self, genNumber=10, popSize=2, **kwargs):
+        with contextlib.redirect_stdout(io.StringIO()):
+            return generator.play(genNumber, popSize, None, **kwargs)
+
+    def test_runs_all_generations(self, evolve, sortPop):
+        self.play()
+        self.assertEqual(evolve.call_count, 10)
+
+    def test_returns_lowest_val(self, evolve, sortPop):
+        pop = generator.initPop(4, seed=5)
+        vals = iter([5, 3, 4, 2])
+        def valuate(kb):
+            kb.val = next(vals)
+        with mock.patch("generator.initPop", return_value=pop), \
+             mock.patch("keyBoard.KeyBoard.valuate", valuate):
+            best = self.play(genNumber=1, popSize=4)
+        self.assertEqual(pop[0].val, 5)
+        self.assertIs(best, pop[3])
+
+    def test_same_seed_same_result(self, evolve, sortPop):
+        self.assertEqual(str(self.play(seed=5)), str(self.play(seed=5)))
+
+    @mock.patch("generator.time.monotonic")
+    def test_max_time(self, monotonic, evolve, sortPop):
+        monotonic.side_effect = range(100)# one second per call
+        self.play(maxTime=3)
+        self.assertEqual(evolve.call_count, 3)
+
+    def test_max_time_runs_one_generation(self, evolve, sortPop):
+        best = self.play(maxTime=0)
+        self.assertEqual(evolve.call_count, 1)
+        self.assertEqual(best.val, 42)
+
+class TestMultiStart(unittest.TestCase):
+    @mock.patch("generator.play")
+    def test_keeps_best_run(self, play):
+        runs = [mock.Mock(val=3), mock.Mock(val=1), mock.Mock(val=2)]
+        play.side_effect = runs
+        with contextlib.redirect_stdout(io.StringIO()):
+            best = generator.multiStart(3, 10, 2, None, seed=5)
+        self.assertIs(best, runs[1])
+        self.assertEqual([c.args[3] for c in play.call_args_list], [5, 6, 7])
+
+    @mock.patch("generator.time.monotonic")
+    @mock.patch("generator.play")
+    def test_shared_max_time(self, play, monotonic):
+        play.return_value = mock.Mock(val=1)
+        monotonic.side_effect = [0, 0, 4, 8, 12]
+        with contextlib.redirect_stdout(io.StringIO()):
+            generator.multiStart(3, 10, 2, None, maxTime=10)
+        self.assertEqual([c.args[4] for c in play.call_args_list], [10, 6, 2])
+
+class TestArgs(unittest.TestCase):
+    def test_multi_start_below_one(self):
+        for value in ["0", "-2"]:
+            with self.assertRaises(argparse.ArgumentTypeError):
+                generator.positiveInt(value)
+        self.assertEqual(generator.positiveInt("1"), 1)
+
+    def test_negative_max_time(self):
+        with self.assertRaises(argparse.ArgumentTypeError):
+            generator.nonNegativeFloat("-1")
+        self.assertEqual(generator.nonNegativeFloat("0"), 0)
+
+if __name__ == "__main__":
+    unittest.main()
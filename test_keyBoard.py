@@ -0,0 +1,58 @@
+# -*- coding: utf-8 -*-
+"""
+@author: tm
+Tests for the keyboard presets
+To run: python3 -m unittest
+"""
+
+import string
+import unittest
+import keyBoard
+
+PRESETS = {
+    "colemak": (keyBoard.COLEMAK,
+                ["qwfpgjluy;", "arstdhneio", "zxcvbkm,./"]),
+    "dvorak": (keyBoard.DVORAK,
+               ["',.pyfgcrl", "aoeuidhtns", ";qjkxbmwvz"]),
+    "workman": (keyBoard.WORKMAN,
+                ["qdrwbjfup;", "ashtgyneoi", "zxmcvkl,./"]),
+}
+
+class TestPresets(unittest.TestCase):
+    def test_row_lengths(self):
+        for name, (layout, rows) in PRESETS.items():
+            for mode in layout:
+                self.assertEqual([len(row) for row in mode[1:]],
+                                 [14, 14, 13, 12, 3], name)
+
+    def test_letters_once(self):
+        for name, (layout, rows) in PRESETS.items():
+            default = [k for row in layout[0][1:] for k in row]
+            shifted = [k for row in layout[1][1:] for k in row]
+            for letter in string.ascii_lowercase:
+                self.assertEqual(default.count(letter), 1, name)
+                self.assertEqual(shifted.count(letter.upper()), 1, name)
+
+    def test_published_positions(self):
+        for name, (layout, rows) in PRESETS.items():
+            self.assertEqual("".join(layout[0][2][1:11]), rows[0], name)
+            self.assertEqual("".join(layout[0][3][1:11]), rows[1], name)
+            self.assertEqual("".join(layout[0][4][1:11]), rows[2], name)
+
+    def test_same_keys_as_qwerty(self):
+        qwerty = sorted(keyBoard.KeyBoard("qwerty").keys)
+        for name in PRESETS:
+            self.assertEqual(sorted(keyBoard.KeyBoard(name).keys), qwerty, name)
+
+    def test_preset_is_copied(self):
+        for name, (layout, rows) in PRESETS.items():
+            first = keyBoard.KeyBoard(name)
+            second = keyBoard.KeyBoard(name)
+            self.assertEqual(first.keyLayout, layout, name)
+            self.assertIsNot(first.keyLayout, layout, name)
+            first.keyLayout[0][3][1] = "?"
+            self.assertNotEqual(layout[0][3][1], "?", name)
+            self.assertNotEqual(second.keyLayout[0][3][1], "?", name)
+
+if __name__ == "__main__":
+    unittest.main()
@@ -53,11 +53,13 @@ def swap(l, posX, posY):
     """
     l[posX], l[posY] = l[posY], l[posX]
 
-def randomizeList(l, seed=random.random()):
+def randomizeList(l, seed=None):
     """
     This randomize the list
+    if <seed> is None, the current random state is used
     """
-    random.seed(seed)
+    if seed is not None:
+        random.seed(seed)
     ll = len(l) - 1
     for x in range(ll):
         swap(l, x, random.randint(x+1, ll))